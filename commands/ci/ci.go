@@ -0,0 +1,44 @@
+// Package ci implements the "lab ci" subcommands (list, status, jobs,
+// trace, retry, cancel, lint), giving local, scriptable control over GitLab CI
+// pipelines for the current repository.
+package ci
+
+import (
+	"github.com/lighttiger2505/lab/git"
+	lab "github.com/lighttiger2505/lab/gitlab"
+)
+
+const (
+	ExitCodeOK    int = iota //0
+	ExitCodeError int = iota //1
+)
+
+// resolve initializes provider and returns the pipeline client and
+// RepositoryFullName for the current repository's remote, the setup
+// every "lab ci" subcommand needs before doing its own work.
+func resolve(provider *lab.Provider) (*lab.Pipeline, string, error) {
+	if err := provider.Init(); err != nil {
+		return nil, "", err
+	}
+
+	remote, err := provider.GetCurrentRemote()
+	if err != nil {
+		return nil, "", err
+	}
+
+	client, err := provider.GetPipelineClient(remote)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return client, remote.RepositoryFullName(), nil
+}
+
+// currentRef returns the branch or ref to operate on when the user
+// didn't pass one explicitly.
+func currentRef(gitClient git.Client, args []string) (string, error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+	return gitClient.CurrentBranch()
+}
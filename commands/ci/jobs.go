@@ -0,0 +1,59 @@
+package ci
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	lab "github.com/lighttiger2505/lab/gitlab"
+	"github.com/lighttiger2505/lab/ui"
+	go_gitlab "github.com/xanzy/go-gitlab"
+)
+
+// JobsCommand implements "lab ci jobs <pipeline-id>", listing the jobs
+// of a pipeline so their IDs can be passed to "lab ci trace".
+type JobsCommand struct {
+	Ui       ui.Ui
+	Provider lab.Provider
+}
+
+func (c *JobsCommand) Synopsis() string {
+	return "List a CI pipeline's jobs"
+}
+
+func (c *JobsCommand) Help() string {
+	return "Usage: lab ci jobs <pipeline-id>"
+}
+
+func (c *JobsCommand) Run(args []string) int {
+	if len(args) < 1 {
+		c.Ui.Error("Pipeline ID is required. Usage: lab ci jobs <pipeline-id>")
+		return ExitCodeError
+	}
+
+	pipelineID, err := strconv.Atoi(args[0])
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Invalid pipeline ID: %s", args[0]))
+		return ExitCodeError
+	}
+
+	client, project, err := resolve(&c.Provider)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return ExitCodeError
+	}
+
+	jobs, err := client.ListJobs(project, pipelineID, &go_gitlab.ListJobsOptions{})
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return ExitCodeError
+	}
+
+	var lines []string
+	for _, job := range jobs {
+		lines = append(lines, fmt.Sprintf("#%d %s %s", job.ID, job.Status, job.Name))
+	}
+
+	c.Ui.Message(strings.Join(lines, "\n"))
+	return ExitCodeOK
+}
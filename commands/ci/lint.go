@@ -0,0 +1,60 @@
+package ci
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	lab "github.com/lighttiger2505/lab/gitlab"
+	"github.com/lighttiger2505/lab/ui"
+)
+
+const defaultCIFile = ".gitlab-ci.yml"
+
+// LintCommand implements "lab ci lint [file]", validating a
+// ".gitlab-ci.yml" document against the /ci/lint API.
+type LintCommand struct {
+	Ui       ui.Ui
+	Provider lab.Provider
+}
+
+func (c *LintCommand) Synopsis() string {
+	return "Validate a .gitlab-ci.yml file"
+}
+
+func (c *LintCommand) Help() string {
+	return "Usage: lab ci lint [file]"
+}
+
+func (c *LintCommand) Run(args []string) int {
+	file := defaultCIFile
+	if len(args) > 0 {
+		file = args[0]
+	}
+
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed read %s: %s", file, err.Error()))
+		return ExitCodeError
+	}
+
+	client, _, err := resolve(&c.Provider)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return ExitCodeError
+	}
+
+	result, err := client.Lint(string(content))
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return ExitCodeError
+	}
+
+	if result.Valid {
+		c.Ui.Message(fmt.Sprintf("%s is valid", file))
+		return ExitCodeOK
+	}
+
+	c.Ui.Error(fmt.Sprintf("%s is invalid:\n%s", file, strings.Join(result.Errors, "\n")))
+	return ExitCodeError
+}
@@ -0,0 +1,46 @@
+package ci
+
+import (
+	"fmt"
+	"strings"
+
+	lab "github.com/lighttiger2505/lab/gitlab"
+	"github.com/lighttiger2505/lab/ui"
+	go_gitlab "github.com/xanzy/go-gitlab"
+)
+
+// ListCommand implements "lab ci list".
+type ListCommand struct {
+	Ui       ui.Ui
+	Provider lab.Provider
+}
+
+func (c *ListCommand) Synopsis() string {
+	return "List CI pipelines"
+}
+
+func (c *ListCommand) Help() string {
+	return "Usage: lab ci list"
+}
+
+func (c *ListCommand) Run(args []string) int {
+	client, project, err := resolve(&c.Provider)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return ExitCodeError
+	}
+
+	pipelines, err := client.List(project, &go_gitlab.ListProjectPipelinesOptions{})
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return ExitCodeError
+	}
+
+	var lines []string
+	for _, pipeline := range pipelines {
+		lines = append(lines, fmt.Sprintf("#%d %s %s", pipeline.ID, pipeline.Status, pipeline.Ref))
+	}
+
+	c.Ui.Message(strings.Join(lines, "\n"))
+	return ExitCodeOK
+}
@@ -0,0 +1,51 @@
+package ci
+
+import (
+	"fmt"
+	"strconv"
+
+	lab "github.com/lighttiger2505/lab/gitlab"
+	"github.com/lighttiger2505/lab/ui"
+)
+
+// RetryCommand implements "lab ci retry <pipeline-id>".
+type RetryCommand struct {
+	Ui       ui.Ui
+	Provider lab.Provider
+}
+
+func (c *RetryCommand) Synopsis() string {
+	return "Retry a CI pipeline"
+}
+
+func (c *RetryCommand) Help() string {
+	return "Usage: lab ci retry <pipeline-id>"
+}
+
+func (c *RetryCommand) Run(args []string) int {
+	if len(args) < 1 {
+		c.Ui.Error("Pipeline ID is required. Usage: lab ci retry <pipeline-id>")
+		return ExitCodeError
+	}
+
+	pipelineID, err := strconv.Atoi(args[0])
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Invalid pipeline ID: %s", args[0]))
+		return ExitCodeError
+	}
+
+	client, project, err := resolve(&c.Provider)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return ExitCodeError
+	}
+
+	pipeline, err := client.Retry(project, pipelineID)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return ExitCodeError
+	}
+
+	c.Ui.Message(fmt.Sprintf("#%d %s", pipeline.ID, pipeline.Status))
+	return ExitCodeOK
+}
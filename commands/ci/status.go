@@ -0,0 +1,52 @@
+package ci
+
+import (
+	"fmt"
+
+	"github.com/lighttiger2505/lab/git"
+	lab "github.com/lighttiger2505/lab/gitlab"
+	"github.com/lighttiger2505/lab/ui"
+)
+
+// StatusCommand implements "lab ci status [<sha>]", reporting the
+// pipeline status for the current branch when no ref is given.
+type StatusCommand struct {
+	Ui        ui.Ui
+	Provider  lab.Provider
+	GitClient git.Client
+}
+
+func (c *StatusCommand) Synopsis() string {
+	return "Show the CI pipeline status for a ref"
+}
+
+func (c *StatusCommand) Help() string {
+	return "Usage: lab ci status [<sha>]"
+}
+
+func (c *StatusCommand) Run(args []string) int {
+	client, project, err := resolve(&c.Provider)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return ExitCodeError
+	}
+
+	ref, err := currentRef(c.GitClient, args)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return ExitCodeError
+	}
+
+	pipeline, err := client.StatusForRef(project, ref)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return ExitCodeError
+	}
+	if pipeline == nil {
+		c.Ui.Message(fmt.Sprintf("No pipeline found for %s", ref))
+		return ExitCodeOK
+	}
+
+	c.Ui.Message(fmt.Sprintf("#%d %s %s", pipeline.ID, pipeline.Status, pipeline.Ref))
+	return ExitCodeOK
+}
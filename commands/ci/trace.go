@@ -0,0 +1,52 @@
+package ci
+
+import (
+	"fmt"
+	"strconv"
+
+	lab "github.com/lighttiger2505/lab/gitlab"
+	"github.com/lighttiger2505/lab/ui"
+)
+
+// TraceCommand implements "lab ci trace <job-id>", printing the job's
+// full log as streamed back by the Jobs API.
+type TraceCommand struct {
+	Ui       ui.Ui
+	Provider lab.Provider
+}
+
+func (c *TraceCommand) Synopsis() string {
+	return "Show a CI job's trace log"
+}
+
+func (c *TraceCommand) Help() string {
+	return "Usage: lab ci trace <job-id>"
+}
+
+func (c *TraceCommand) Run(args []string) int {
+	if len(args) < 1 {
+		c.Ui.Error("Job ID is required. Usage: lab ci trace <job-id>")
+		return ExitCodeError
+	}
+
+	jobID, err := strconv.Atoi(args[0])
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Invalid job ID: %s", args[0]))
+		return ExitCodeError
+	}
+
+	client, project, err := resolve(&c.Provider)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return ExitCodeError
+	}
+
+	trace, err := client.Trace(project, jobID)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return ExitCodeError
+	}
+
+	c.Ui.Message(trace)
+	return ExitCodeOK
+}
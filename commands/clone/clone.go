@@ -0,0 +1,159 @@
+// Package clone implements "lab clone", a thin wrapper around "git
+// clone" that also pulls down LFS content when the repository uses it.
+package clone
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/lighttiger2505/lab/config"
+	"github.com/lighttiger2505/lab/ui"
+)
+
+const (
+	ExitCodeOK    int = iota //0
+	ExitCodeError int = iota //1
+)
+
+type CloneOption struct {
+	Host string `long:"host" value-name:"<host>" default:"gitlab.com" default-mask:"gitlab.com" description:"The GitLab host to clone from"`
+}
+
+func newCloneOptionParser(opt *CloneOption) *flags.Parser {
+	parser := flags.NewParser(opt, flags.Default)
+	parser.Usage = `clone - Clone a GitLab project, fetching LFS content if the repository uses it
+
+Synopsis:
+  lab clone [--host <host>] <project>
+
+  # project may be "namespace/repo" or a full git URL
+  lab clone lighttiger2505/lab
+  lab clone --host git.mycorp.com group/subgroup/repo`
+	return parser
+}
+
+// CloneCommand clones a GitLab project and, when the fresh checkout
+// uses Git LFS, runs "git lfs install" + "git lfs fetch --all" so the
+// user ends up with a fully working tree in one command.
+type CloneCommand struct {
+	Ui    ui.Ui
+	Hosts []config.HostConfig
+}
+
+func (c *CloneCommand) Synopsis() string {
+	return "Clone a GitLab project"
+}
+
+func (c *CloneCommand) Help() string {
+	buf := &bytes.Buffer{}
+	var opt CloneOption
+	newCloneOptionParser(&opt).WriteHelp(buf)
+	return buf.String()
+}
+
+func (c *CloneCommand) Run(args []string) int {
+	var opt CloneOption
+	parser := newCloneOptionParser(&opt)
+	parseArgs, err := parser.ParseArgs(args)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return ExitCodeError
+	}
+
+	if len(parseArgs) < 1 {
+		c.Ui.Error("Project is required. Usage: lab clone <project>")
+		return ExitCodeError
+	}
+
+	cloneUrl, err := c.resolveCloneUrl(parseArgs[0], opt.Host)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return ExitCodeError
+	}
+
+	dir, err := runClone(cloneUrl)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return ExitCodeError
+	}
+
+	if usesLFS(dir) {
+		if err := fetchLFS(dir); err != nil {
+			c.Ui.Error(err.Error())
+			return ExitCodeError
+		}
+	}
+
+	c.Ui.Message(fmt.Sprintf("Cloned into %s", dir))
+	return ExitCodeOK
+}
+
+func (c *CloneCommand) resolveCloneUrl(project, host string) (string, error) {
+	if strings.Contains(project, "://") || strings.HasPrefix(project, "git@") {
+		return project, nil
+	}
+
+	if !config.HasHost(c.Hosts, host) {
+		return "", fmt.Errorf("No host configured for %s, run `lab config add-host`", host)
+	}
+	return fmt.Sprintf("ssh://git@%s/%s.git", host, project), nil
+}
+
+func runClone(cloneUrl string) (string, error) {
+	cmd := exec.Command("git", "clone", cloneUrl)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", errors.New(fmt.Sprintf("Failed clone %s: %s", cloneUrl, err.Error()))
+	}
+	return destinationDir(cloneUrl), nil
+}
+
+func destinationDir(cloneUrl string) string {
+	base := filepath.Base(cloneUrl)
+	return strings.TrimSuffix(base, ".git")
+}
+
+// usesLFS reports whether the fresh checkout uses LFS, detected by the
+// presence of "filter=lfs" attributes in its top-level .gitattributes.
+// It reports false when git-lfs is not installed, so the LFS step is
+// then skipped.
+func usesLFS(dir string) bool {
+	if _, err := exec.LookPath("git-lfs"); err != nil {
+		return false
+	}
+
+	content, err := ioutil.ReadFile(filepath.Join(dir, ".gitattributes"))
+	if err != nil {
+		return false
+	}
+
+	return strings.Contains(string(content), "filter=lfs")
+}
+
+func fetchLFS(dir string) error {
+	install := exec.Command("git", "lfs", "install")
+	install.Dir = dir
+	install.Stdout = os.Stdout
+	install.Stderr = os.Stderr
+	if err := install.Run(); err != nil {
+		return errors.New(fmt.Sprintf("Failed run git lfs install: %s", err.Error()))
+	}
+
+	fetch := exec.Command("git", "lfs", "fetch", "--all")
+	fetch.Dir = dir
+	fetch.Stdout = os.Stdout
+	fetch.Stderr = os.Stderr
+	if err := fetch.Run(); err != nil {
+		return errors.New(fmt.Sprintf("Failed run git lfs fetch --all: %s", err.Error()))
+	}
+
+	return nil
+}
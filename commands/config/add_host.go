@@ -0,0 +1,75 @@
+// Package config implements "lab config" subcommands.
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lighttiger2505/lab/config"
+	"github.com/lighttiger2505/lab/ui"
+)
+
+const (
+	ExitCodeOK    int = iota //0
+	ExitCodeError int = iota //1
+)
+
+// AddHostCommand interactively prompts for a GitLab host profile and
+// persists it to ".labconfig.yml", so that self-hosted instances can be
+// used alongside (or instead of) gitlab.com.
+type AddHostCommand struct {
+	Ui ui.Ui
+}
+
+func (c *AddHostCommand) Synopsis() string {
+	return "Add a GitLab host profile to the config file"
+}
+
+func (c *AddHostCommand) Help() string {
+	return "Usage: lab config add-host"
+}
+
+func (c *AddHostCommand) Run(args []string) int {
+	reader := bufio.NewReader(os.Stdin)
+
+	domain := prompt(reader, fmt.Sprintf("GitLab host (e.g. %s) : ", config.DefaultDomain))
+	if domain == "" {
+		domain = config.DefaultDomain
+	}
+
+	token := prompt(reader, "Private token : ")
+	if token == "" {
+		c.Ui.Error("Private token is required")
+		return ExitCodeError
+	}
+
+	apiVersion := prompt(reader, fmt.Sprintf("API version [%s] : ", config.DefaultAPIVersion))
+	if apiVersion == "" {
+		apiVersion = config.DefaultAPIVersion
+	}
+
+	insecure := strings.EqualFold(prompt(reader, "Skip TLS certificate verification? [y/N] : "), "y")
+
+	host := config.HostConfig{
+		Domain:             domain,
+		PrivateToken:       token,
+		APIVersion:         apiVersion,
+		InsecureSkipVerify: insecure,
+	}
+
+	if err := config.AddHost(host); err != nil {
+		c.Ui.Error(err.Error())
+		return ExitCodeError
+	}
+
+	c.Ui.Message(fmt.Sprintf("Added host %s", domain))
+	return ExitCodeOK
+}
+
+func prompt(reader *bufio.Reader, message string) string {
+	fmt.Print(message)
+	line, _ := reader.ReadString('\n')
+	return strings.TrimSpace(line)
+}
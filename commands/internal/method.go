@@ -0,0 +1,12 @@
+// Package internal holds small shared types used by the command
+// packages (mr, issue, ...) so that each subcommand's verbs
+// (list, show, create, update, ...) can be modeled as an interchangeable
+// unit of work.
+package internal
+
+// Method represents a single unit of work dispatched by a command's
+// getMethod. Each subcommand verb (list, detail, create, update, ...)
+// implements Method and returns the string to print to the user.
+type Method interface {
+	Process() (string, error)
+}
@@ -0,0 +1,92 @@
+package issue
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	lab "github.com/lighttiger2505/lab/gitlab"
+	go_gitlab "github.com/xanzy/go-gitlab"
+)
+
+type createMethod struct {
+	client  *lab.Issue
+	opt     *CreateUpdateIssueOption
+	project string
+}
+
+func (m *createMethod) Process() (string, error) {
+	opt, err := createIssueOptions(m.opt, m.opt.Title, m.opt.Message)
+	if err != nil {
+		return "", err
+	}
+
+	issue, err := m.client.Create(m.project, opt)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("#%d %s", issue.IID, issue.Title), nil
+}
+
+type createOnEditorMethod struct {
+	client   *lab.Issue
+	opt      *CreateUpdateIssueOption
+	project  string
+	template string
+	editFunc func(program, file string) error
+}
+
+func (m *createOnEditorMethod) Process() (string, error) {
+	title, description, err := editIssueTitleAndDesc(m.template, m.editFunc)
+	if err != nil {
+		return "", err
+	}
+
+	opt, err := createIssueOptions(m.opt, title, description)
+	if err != nil {
+		return "", err
+	}
+
+	issue, err := m.client.Create(m.project, opt)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("#%d %s", issue.IID, issue.Title), nil
+}
+
+func createIssueOptions(opt *CreateUpdateIssueOption, title, description string) (*go_gitlab.CreateIssueOptions, error) {
+	createOpt := &go_gitlab.CreateIssueOptions{
+		Title:       go_gitlab.String(title),
+		Description: go_gitlab.String(description),
+	}
+
+	if opt.AssigneeID != 0 {
+		createOpt.AssigneeIDs = []int{opt.AssigneeID}
+	}
+	if opt.Label != "" {
+		createOpt.Labels = strings.Split(opt.Label, ",")
+	}
+	if opt.Milestone != "" {
+		milestoneID, err := parseMilestoneID(opt.Milestone)
+		if err != nil {
+			return nil, err
+		}
+		createOpt.MilestoneID = go_gitlab.Int(milestoneID)
+	}
+
+	return createOpt, nil
+}
+
+// parseMilestoneID interprets the --milestone flag as a milestone ID.
+// Lab has no lookup from milestone title to ID yet, so a non-numeric
+// value is rejected rather than silently coerced to 0, which GitLab
+// would interpret as "remove milestone".
+func parseMilestoneID(milestone string) (int, error) {
+	id, err := strconv.Atoi(milestone)
+	if err != nil {
+		return 0, fmt.Errorf("--milestone must be a milestone ID, got %q", milestone)
+	}
+	return id, nil
+}
@@ -0,0 +1,22 @@
+package issue
+
+import (
+	"fmt"
+
+	lab "github.com/lighttiger2505/lab/gitlab"
+)
+
+type detailMethod struct {
+	client  *lab.Issue
+	project string
+	id      int
+}
+
+func (m *detailMethod) Process() (string, error) {
+	issue, err := m.client.Show(m.project, m.id)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("#%d %s\n\n%s", issue.IID, issue.Title, issue.Description), nil
+}
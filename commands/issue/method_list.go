@@ -0,0 +1,63 @@
+package issue
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	lab "github.com/lighttiger2505/lab/gitlab"
+	go_gitlab "github.com/xanzy/go-gitlab"
+)
+
+type listMethod struct {
+	client  *lab.Issue
+	opt     *ListIssueOption
+	project string
+}
+
+func (m *listMethod) Process() (string, error) {
+	opt := &go_gitlab.ListProjectIssuesOptions{
+		ListOptions: go_gitlab.ListOptions{PerPage: m.opt.Num},
+		State:       go_gitlab.String(m.opt.GetState()),
+		Scope:       go_gitlab.String(m.opt.GetScope()),
+		OrderBy:     go_gitlab.String(m.opt.OrderBy),
+		Sort:        go_gitlab.String(m.opt.Sort),
+	}
+
+	issues, err := m.client.List(m.project, opt)
+	if err != nil {
+		return "", err
+	}
+
+	return issuesOutput(issues), nil
+}
+
+type listAllMethod struct {
+	client *lab.Issue
+	opt    *ListIssueOption
+}
+
+func (m *listAllMethod) Process() (string, error) {
+	opt := &go_gitlab.ListIssuesOptions{
+		ListOptions: go_gitlab.ListOptions{PerPage: m.opt.Num},
+		State:       go_gitlab.String(m.opt.GetState()),
+		Scope:       go_gitlab.String(m.opt.GetScope()),
+		OrderBy:     go_gitlab.String(m.opt.OrderBy),
+		Sort:        go_gitlab.String(m.opt.Sort),
+	}
+
+	issues, err := m.client.ListAll(opt)
+	if err != nil {
+		return "", err
+	}
+
+	return issuesOutput(issues), nil
+}
+
+func issuesOutput(issues []*go_gitlab.Issue) string {
+	var lines []string
+	for _, issue := range issues {
+		lines = append(lines, fmt.Sprintf("#%s %s", strconv.Itoa(issue.IID), issue.Title))
+	}
+	return strings.Join(lines, "\n")
+}
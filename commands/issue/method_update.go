@@ -0,0 +1,92 @@
+package issue
+
+import (
+	"fmt"
+	"strings"
+
+	lab "github.com/lighttiger2505/lab/gitlab"
+	go_gitlab "github.com/xanzy/go-gitlab"
+)
+
+type updateMethod struct {
+	client  *lab.Issue
+	opt     *CreateUpdateIssueOption
+	project string
+	id      int
+}
+
+func (m *updateMethod) Process() (string, error) {
+	opt, err := updateIssueOptions(m.opt, m.opt.Title, m.opt.Message)
+	if err != nil {
+		return "", err
+	}
+
+	issue, err := m.client.Update(m.project, m.id, opt)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("#%d %s", issue.IID, issue.Title), nil
+}
+
+type updateOnEditorMethod struct {
+	client   *lab.Issue
+	opt      *CreateUpdateIssueOption
+	project  string
+	id       int
+	editFunc func(program, file string) error
+}
+
+func (m *updateOnEditorMethod) Process() (string, error) {
+	issue, err := m.client.Show(m.project, m.id)
+	if err != nil {
+		return "", err
+	}
+
+	template := editIssueTemplate(issue.Title, issue.Description)
+	title, description, err := editIssueTitleAndDesc(template, m.editFunc)
+	if err != nil {
+		return "", err
+	}
+
+	opt, err := updateIssueOptions(m.opt, title, description)
+	if err != nil {
+		return "", err
+	}
+
+	updated, err := m.client.Update(m.project, m.id, opt)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("#%d %s", updated.IID, updated.Title), nil
+}
+
+func updateIssueOptions(opt *CreateUpdateIssueOption, title, description string) (*go_gitlab.UpdateIssueOptions, error) {
+	updateOpt := &go_gitlab.UpdateIssueOptions{}
+
+	if title != "" {
+		updateOpt.Title = go_gitlab.String(title)
+	}
+	if description != "" {
+		updateOpt.Description = go_gitlab.String(description)
+	}
+	if opt.StateEvent != "" {
+		updateOpt.StateEvent = go_gitlab.String(opt.StateEvent)
+	}
+	if opt.AssigneeID != 0 {
+		updateOpt.AssigneeIDs = []int{opt.AssigneeID}
+	}
+	if opt.Label != "" {
+		updateOpt.Labels = strings.Split(opt.Label, ",")
+	}
+	if opt.Milestone != "" {
+		milestoneID, err := parseMilestoneID(opt.Milestone)
+		if err != nil {
+			return nil, err
+		}
+		updateOpt.MilestoneID = go_gitlab.Int(milestoneID)
+	}
+
+	return updateOpt, nil
+}
@@ -0,0 +1,46 @@
+package mr
+
+import (
+	"fmt"
+
+	"github.com/lighttiger2505/lab/git"
+	lab "github.com/lighttiger2505/lab/gitlab"
+)
+
+type checkoutMethod struct {
+	client        *lab.MergeRequest
+	projectClient *lab.Project
+	gitClient     git.Client
+	project       string
+	id            int
+}
+
+func (m *checkoutMethod) Process() (string, error) {
+	mergeRequest, err := m.client.Show(m.project, m.id)
+	if err != nil {
+		return "", err
+	}
+
+	localBranch := fmt.Sprintf("mr/%d", m.id)
+
+	// Same project: the source branch already lives on "origin".
+	if mergeRequest.SourceProjectID == mergeRequest.TargetProjectID {
+		if err := m.gitClient.CheckoutRemoteBranch("origin", "", mergeRequest.SourceBranch, localBranch); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Switched to branch '%s'", localBranch), nil
+	}
+
+	// Forked project: register a remote for the fork and fetch from there.
+	sourceProject, err := m.projectClient.Get(mergeRequest.SourceProjectID)
+	if err != nil {
+		return "", fmt.Errorf("Failed resolve source project of !%d: %s", m.id, err.Error())
+	}
+
+	remoteName := fmt.Sprintf("mr-%d", m.id)
+	if err := m.gitClient.CheckoutRemoteBranch(remoteName, sourceProject.SSHURLToRepo, mergeRequest.SourceBranch, localBranch); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Switched to branch '%s' (tracking %s/%s)", localBranch, remoteName, mergeRequest.SourceBranch), nil
+}
@@ -0,0 +1,63 @@
+package mr
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	lab "github.com/lighttiger2505/lab/gitlab"
+	go_gitlab "github.com/xanzy/go-gitlab"
+)
+
+type mergeMethod struct {
+	client  *lab.MergeRequest
+	opt     *MergeOption
+	project string
+	id      int
+}
+
+func (m *mergeMethod) Process() (string, error) {
+	opt := &go_gitlab.AcceptMergeRequestOptions{}
+	if m.opt.Squash {
+		opt.Squash = go_gitlab.Bool(true)
+	}
+	if m.opt.RemoveSourceBranch {
+		opt.ShouldRemoveSourceBranch = go_gitlab.Bool(true)
+	}
+
+	mergeRequest, resp, err := m.client.Accept(m.project, m.id, opt)
+	if err != nil {
+		if isConflict(resp) {
+			return "", m.conflictError()
+		}
+		return "", err
+	}
+
+	return fmt.Sprintf("Merged !%d %s", mergeRequest.IID, mergeRequest.Title), nil
+}
+
+func isConflict(resp *go_gitlab.Response) bool {
+	if resp == nil {
+		return false
+	}
+	return resp.StatusCode == http.StatusMethodNotAllowed || resp.StatusCode == http.StatusConflict
+}
+
+// conflictError turns a raw Accept-MR conflict response into a
+// friendlier diagnostic than the raw API error. The changes endpoint
+// only reports every file touched by the MR, not which of them
+// actually conflict, so the message is worded to match what it really
+// knows.
+func (m *mergeMethod) conflictError() error {
+	mergeRequest, err := m.client.Changes(m.project, m.id)
+	if err != nil {
+		return fmt.Errorf("Merge request !%d cannot be merged automatically, and fetching its changed files failed: %s", m.id, err.Error())
+	}
+
+	var files []string
+	for _, change := range mergeRequest.Changes {
+		files = append(files, change.NewPath)
+	}
+
+	return fmt.Errorf("Merge request !%d cannot be merged automatically (conflicts with the target branch). Changed files in !%d:\n  %s", m.id, m.id, strings.Join(files, "\n  "))
+}
@@ -66,14 +66,21 @@ func (l *ListMergeRequestOption) GetScope() string {
 	return l.Scope
 }
 
+type MergeOption struct {
+	Squash             bool `long:"squash" description:"Squash the commits into a single commit when merging."`
+	RemoveSourceBranch bool `long:"remove-source-branch" description:"Remove the source branch after the merge request is merged."`
+}
+
 type MergeRequestCommandOption struct {
 	CreateUpdateOption *CreateUpdateMergeRequestOption `group:"Create, Update Options"`
 	ListOption         *ListMergeRequestOption         `group:"List Options"`
+	MergeOption        *MergeOption                    `group:"Merge Options"`
 }
 
 func newMergeRequestOptionParser(opt *MergeRequestCommandOption) *flags.Parser {
 	opt.CreateUpdateOption = &CreateUpdateMergeRequestOption{}
 	opt.ListOption = &ListMergeRequestOption{}
+	opt.MergeOption = &MergeOption{}
 	parser := flags.NewParser(opt, flags.Default)
 	parser.Usage = `merge-request - Create and Edit, list a merge request
 
@@ -90,7 +97,13 @@ Synopsis:
   lab merge-request <MergeRequest IID> [-t <title>] [-d <description>] [--state-event=<state>] [--assignee-id=<assignee id>]
 
   # Show merge request
-  lab merge-request <MergeRequest IID>`
+  lab merge-request <MergeRequest IID>
+
+  # Check out a merge request's source branch
+  lab merge-request checkout <MergeRequest IID>
+
+  # Merge a merge request
+  lab merge-request merge <MergeRequest IID> [--squash] [--remove-source-branch]`
 	return parser
 }
 
@@ -157,12 +170,46 @@ func (c *MergeRequestCommand) Run(args []string) int {
 func (c *MergeRequestCommand) getMethod(opt MergeRequestCommandOption, args []string, remote *git.RemoteInfo) (internal.Method, error) {
 	createUpdateOption := opt.CreateUpdateOption
 	listOption := opt.ListOption
+	mergeOption := opt.MergeOption
 
 	client, err := c.Provider.GetMergeRequestClient(remote)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(args) > 0 && (args[0] == "checkout" || args[0] == "merge") {
+		verb, rest := args[0], args[1:]
+
+		iid, err := validMergeRequestIID(rest)
+		if err != nil {
+			return nil, err
+		}
+		if iid == 0 {
+			return nil, fmt.Errorf("Merge Request IID is required. Usage: lab merge-request %s <IID>", verb)
+		}
+
+		if verb == "checkout" {
+			projectClient, err := c.Provider.GetProjectClient(remote)
+			if err != nil {
+				return nil, err
+			}
+			return &checkoutMethod{
+				client:        client,
+				projectClient: projectClient,
+				gitClient:     c.GitClient,
+				project:       remote.RepositoryFullName(),
+				id:            iid,
+			}, nil
+		}
+
+		return &mergeMethod{
+			client:  client,
+			opt:     mergeOption,
+			project: remote.RepositoryFullName(),
+			id:      iid,
+		}, nil
+	}
+
 	repositoryClient, err := c.Provider.GetRepositoryClient(remote)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,160 @@
+// Package config loads and persists ".labconfig.yml", which holds one
+// profile per GitLab host so that lab can talk to self-hosted instances
+// as well as gitlab.com.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/viper"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// DefaultDomain is used whenever a remote's domain has no matching host
+// entry, so that a config file holding only a gitlab.com profile keeps
+// working for gitlab.com remotes.
+const DefaultDomain = "gitlab.com"
+
+// DefaultAPIVersion is used for a host entry that doesn't specify one.
+const DefaultAPIVersion = "v4"
+
+// HostConfig is a single ".labconfig.yml" profile, keyed by hostname.
+type HostConfig struct {
+	Domain             string `yaml:"domain" mapstructure:"domain"`
+	PrivateToken       string `yaml:"private_token" mapstructure:"private_token"`
+	APIVersion         string `yaml:"api_version" mapstructure:"api_version"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify" mapstructure:"insecure_skip_verify"`
+}
+
+type fileConfig struct {
+	Hosts []HostConfig `yaml:"hosts" mapstructure:"hosts"`
+}
+
+// Read loads the configured hosts, creating a config file via an
+// interactive prompt on first run.
+func Read() ([]HostConfig, error) {
+	viper.SetConfigName(".labconfig")
+	viper.SetConfigType("yaml")
+	viper.AddConfigPath("$HOME")
+	viper.AddConfigPath("$HOME/.lab")
+	if err := viper.ReadInConfig(); err != nil {
+		if err := Create(); err != nil {
+			return nil, errors.New(fmt.Sprintf("Failed create config file: %s", err.Error()))
+		}
+
+		if err := viper.ReadInConfig(); err != nil {
+			return nil, errors.New(fmt.Sprintf("Failed read config file: %s", err.Error()))
+		}
+	}
+
+	var cfg fileConfig
+	if err := viper.Unmarshal(&cfg); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed parse config file: %s", err.Error()))
+	}
+
+	return cfg.Hosts, nil
+}
+
+// Create interactively prompts for a gitlab.com private token and
+// writes the initial ".labconfig.yml".
+func Create() error {
+	fmt.Print("Plase input GitLab private token :")
+	var token string
+	fmt.Scanln(&token)
+
+	return write([]HostConfig{
+		{
+			Domain:       DefaultDomain,
+			PrivateToken: token,
+			APIVersion:   DefaultAPIVersion,
+		},
+	})
+}
+
+// AddHost adds or replaces the host entry matching host.Domain and
+// persists the result.
+func AddHost(host HostConfig) error {
+	hosts, err := Read()
+	if err != nil {
+		return err
+	}
+
+	replaced := false
+	for i, h := range hosts {
+		if h.Domain == host.Domain {
+			hosts[i] = host
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		hosts = append(hosts, host)
+	}
+
+	return write(hosts)
+}
+
+func write(hosts []HostConfig) error {
+	dir, err := homedir.Dir()
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed get home dir: %s", err.Error()))
+	}
+
+	out, err := yaml.Marshal(fileConfig{Hosts: hosts})
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed encode config file: %s", err.Error()))
+	}
+
+	path := fmt.Sprintf("%s/.labconfig.yml", dir)
+	if err := os.WriteFile(path, out, 0644); err != nil {
+		return errors.New(fmt.Sprintf("Failed write config file: %s", err.Error()))
+	}
+
+	return nil
+}
+
+// FindHost returns the host entry matching domain, falling back to the
+// gitlab.com entry (if any) when nothing matches. It is meant for
+// resolving which token/API version/base URL to use once a remote has
+// already been established to be a GitLab remote; use HasHost for that
+// membership test instead, since FindHost's fallback would otherwise
+// make every domain look configured as soon as a gitlab.com entry
+// exists.
+func FindHost(hosts []HostConfig, domain string) (*HostConfig, bool) {
+	var fallback *HostConfig
+	for i, h := range hosts {
+		if h.Domain == domain {
+			return &hosts[i], true
+		}
+		if h.Domain == DefaultDomain {
+			fallback = &hosts[i]
+		}
+	}
+	return fallback, fallback != nil
+}
+
+// HasHost reports whether domain has its own entry in hosts, with no
+// gitlab.com fallback. Use this to decide whether a remote is actually
+// a configured GitLab host.
+func HasHost(hosts []HostConfig, domain string) bool {
+	for _, h := range hosts {
+		if h.Domain == domain {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPrivateTokenFor returns the configured private token for domain,
+// using the gitlab.com entry's token when domain has no entry of its
+// own (see FindHost), or an empty string when neither exists.
+func GetPrivateTokenFor(hosts []HostConfig, domain string) string {
+	host, ok := FindHost(hosts, domain)
+	if !ok {
+		return ""
+	}
+	return host.PrivateToken
+}
@@ -0,0 +1,81 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Client is the thin wrapper command packages use to talk to the local
+// git binary through a Runner. "lab clone" does not use it and runs
+// "git clone" and "git lfs" itself.
+type Client interface {
+	RemoteInfos() ([]*RemoteInfo, error)
+	CurrentBranch() (string, error)
+	CheckoutRemoteBranch(remoteName, remoteUrl, branch, localBranch string) error
+}
+
+type client struct {
+	runner Runner
+}
+
+// NewClient returns the default Client backed by the local git binary.
+func NewClient() Client {
+	return &client{runner: NewRunner()}
+}
+
+func (c *client) RemoteInfos() ([]*RemoteInfo, error) {
+	out, _, err := c.runner.Run("remote")
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []*RemoteInfo
+	for _, name := range strings.Fields(out) {
+		url, _, err := c.runner.Run("remote", "get-url", name)
+		if err != nil {
+			continue
+		}
+
+		info, err := NewRemoteUrl(strings.TrimSpace(url))
+		if err != nil {
+			continue
+		}
+
+		infos = append(infos, info)
+	}
+
+	if len(infos) == 0 {
+		return nil, errors.New("No remote setting in this repository")
+	}
+
+	return infos, nil
+}
+
+func (c *client) CurrentBranch() (string, error) {
+	out, _, err := c.runner.Run("rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// CheckoutRemoteBranch fetches branch from remoteName (adding it
+// pointing at remoteUrl first, if it isn't already registered) and
+// checks it out as a new local tracking branch localBranch. Used to
+// check out a merge request's source branch, which may live on a
+// contributor's fork rather than the current "origin".
+func (c *client) CheckoutRemoteBranch(remoteName, remoteUrl, branch, localBranch string) error {
+	if remoteUrl != "" {
+		// Ignore the error: the remote may already be registered from a
+		// previous checkout of the same fork.
+		c.runner.Run("remote", "add", remoteName, remoteUrl)
+	}
+
+	if _, _, err := c.runner.Run("fetch", remoteName, branch); err != nil {
+		return err
+	}
+
+	_, _, err := c.runner.Run("checkout", "-b", localBranch, fmt.Sprintf("%s/%s", remoteName, branch))
+	return err
+}
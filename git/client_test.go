@@ -0,0 +1,70 @@
+package git
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type stubRunner struct {
+	outputs map[string]string
+	err     error
+}
+
+func (s *stubRunner) Run(args ...string) (string, string, error) {
+	if s.err != nil {
+		return "", "", s.err
+	}
+	key := ""
+	for _, a := range args {
+		key += a + " "
+	}
+	return s.outputs[key], "", nil
+}
+
+func TestClientRemoteInfos(t *testing.T) {
+	runner := &stubRunner{
+		outputs: map[string]string{
+			"remote ":                "origin\n",
+			"remote get-url origin ": "git@gitlab.com:lighttiger2505/lab.git\n",
+		},
+	}
+	c := &client{runner: runner}
+
+	infos, err := c.RemoteInfos()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []*RemoteInfo{
+		{Domain: "gitlab.com", Namespace: "lighttiger2505", Repository: "lab"},
+	}
+	if !reflect.DeepEqual(infos, want) {
+		t.Errorf("got %+v, want %+v", infos, want)
+	}
+}
+
+func TestClientRemoteInfosNotARepo(t *testing.T) {
+	c := &client{runner: &stubRunner{err: ErrNotARepo}}
+
+	if _, err := c.RemoteInfos(); !errors.Is(err, ErrNotARepo) {
+		t.Errorf("got %v, want ErrNotARepo", err)
+	}
+}
+
+func TestClientCurrentBranch(t *testing.T) {
+	runner := &stubRunner{
+		outputs: map[string]string{
+			"rev-parse --abbrev-ref HEAD ": "feature/foo\n",
+		},
+	}
+	c := &client{runner: runner}
+
+	branch, err := c.CurrentBranch()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if branch != "feature/foo" {
+		t.Errorf("got %q, want %q", branch, "feature/foo")
+	}
+}
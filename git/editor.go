@@ -0,0 +1,122 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Editor opens a temporary file pre-filled with template so the user
+// can compose a title and description in $EDITOR, then parses the
+// result back out. Used by the "-e" editor flows of merge-request and
+// issue.
+type Editor struct {
+	Program  string
+	Filename string
+	path     string
+}
+
+// NewEditor creates a temporary file named "<prefix>_EDITMSG" under the
+// given kind's subdirectory, seeded with template, runs the editor on
+// it through editFunc, and returns an Editor from which the edited
+// title and description can be read back. editFunc defaults to invoking
+// the resolved editor and is overridable for tests. The editor itself
+// is resolved by resolveEditor.
+func NewEditor(prefix, kind, template string, editFunc func(program, file string) error) (*Editor, error) {
+	return newEditor(NewRunner(), prefix, kind, template, editFunc)
+}
+
+func newEditor(runner Runner, prefix, kind, template string, editFunc func(program, file string) error) (*Editor, error) {
+	dir, err := ioutil.TempDir("", fmt.Sprintf("lab-%s", kind))
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed create temp dir: %s", err.Error()))
+	}
+
+	path := fmt.Sprintf("%s/%s_EDITMSG", dir, prefix)
+	if err := ioutil.WriteFile(path, []byte(template), 0644); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed write temp file: %s", err.Error()))
+	}
+
+	program, err := resolveEditor(runner)
+	if err != nil {
+		return nil, err
+	}
+
+	if editFunc == nil {
+		editFunc = runEditor
+	}
+
+	if err := editFunc(program, path); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed run editor: %s", err.Error()))
+	}
+
+	return &Editor{
+		Program:  program,
+		Filename: path,
+		path:     path,
+	}, nil
+}
+
+// resolveEditor uses $EDITOR when it is set, then git's core.editor
+// setting, and finally the editor reported by "git var GIT_EDITOR".
+func resolveEditor(runner Runner) (string, error) {
+	if program := os.Getenv("EDITOR"); program != "" {
+		return program, nil
+	}
+
+	if out, _, err := runner.Run("config", "core.editor"); err == nil {
+		if program := strings.TrimSpace(out); program != "" {
+			return program, nil
+		}
+	}
+
+	out, _, err := runner.Run("var", "GIT_EDITOR")
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("Failed resolve editor: %s", err.Error()))
+	}
+
+	program := strings.TrimSpace(out)
+	if program == "" {
+		return "", errors.New("No editor configured, set $EDITOR or git's core.editor")
+	}
+
+	return program, nil
+}
+
+func runEditor(program, file string) error {
+	cmd := exec.Command(program, file)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// EditTitleAndDescription reads back the edited file, treating the
+// first line as the title and the remaining lines as the description.
+func (e *Editor) EditTitleAndDescription() (string, string, error) {
+	out, err := ioutil.ReadFile(e.path)
+	if err != nil {
+		return "", "", errors.New(fmt.Sprintf("Failed read edited file: %s", err.Error()))
+	}
+
+	lines := strings.Split(string(out), "\n")
+	if len(lines) == 0 {
+		return "", "", errors.New("Title is empty")
+	}
+
+	title := strings.TrimSpace(lines[0])
+	if title == "" {
+		return "", "", errors.New("Title is empty")
+	}
+
+	description := strings.TrimSpace(strings.Join(lines[1:], "\n"))
+	return title, description, nil
+}
+
+// DeleteFile removes the temporary edit file.
+func (e *Editor) DeleteFile() error {
+	return os.Remove(e.path)
+}
@@ -0,0 +1,59 @@
+package git
+
+import "testing"
+
+func TestResolveEditorFallsBackToGitConfig(t *testing.T) {
+	t.Setenv("EDITOR", "")
+
+	runner := &stubRunner{
+		outputs: map[string]string{
+			"config core.editor ": "nano\n",
+		},
+	}
+
+	program, err := resolveEditor(runner)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if program != "nano" {
+		t.Errorf("got %q, want %q", program, "nano")
+	}
+}
+
+func TestNewEditorWritesTemplate(t *testing.T) {
+	t.Setenv("EDITOR", "")
+
+	runner := &stubRunner{
+		outputs: map[string]string{
+			"config core.editor ": "nano\n",
+		},
+	}
+
+	var gotProgram, gotFile string
+	editFunc := func(program, file string) error {
+		gotProgram = program
+		gotFile = file
+		return nil
+	}
+
+	editor, err := newEditor(runner, "ISSUE", "issue", "Title\n\nBody", editFunc)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer editor.DeleteFile()
+
+	if gotProgram != "nano" {
+		t.Errorf("got program %q, want %q", gotProgram, "nano")
+	}
+	if gotFile != editor.Filename {
+		t.Errorf("got file %q, want %q", gotFile, editor.Filename)
+	}
+
+	title, description, err := editor.EditTitleAndDescription()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if title != "Title" || description != "Body" {
+		t.Errorf("got title=%q description=%q", title, description)
+	}
+}
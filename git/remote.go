@@ -0,0 +1,17 @@
+package git
+
+import "strings"
+
+// RemoteInfo describes a single git remote resolved to the values the
+// GitLab API needs: which host to talk to and which project to address.
+type RemoteInfo struct {
+	Domain     string
+	Namespace  string
+	Repository string
+}
+
+// RepositoryFullName returns the "namespace/repository" form GitLab's
+// project API expects.
+func (r *RemoteInfo) RepositoryFullName() string {
+	return strings.Join([]string{r.Namespace, r.Repository}, "/")
+}
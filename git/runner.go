@@ -0,0 +1,64 @@
+package git
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// DefaultLocale is set as LC_ALL/LANG for every git invocation so that
+// output stays in English and is safe to pattern-match, regardless of
+// the user's system locale. Overridable at build time, following
+// gitea's approach to the same problem:
+//
+//	go build -ldflags "-X github.com/lighttiger2505/lab/git.DefaultLocale=C"
+var DefaultLocale = "C"
+
+// Typed errors callers can check for with errors.Is, instead of
+// pattern-matching raw, potentially localized git output.
+var (
+	ErrNotARepo      = errors.New("not a git repository")
+	ErrRemoteMissing = errors.New("remote does not exist")
+)
+
+// Runner runs git commands with a forced English locale and captures
+// stdout/stderr separately, so callers can parse output reliably and
+// tests can stub it out without a real git binary.
+type Runner interface {
+	Run(args ...string) (stdout, stderr string, err error)
+}
+
+// NewRunner returns the default Runner, backed by the local git binary.
+func NewRunner() Runner {
+	return &execRunner{}
+}
+
+type execRunner struct{}
+
+func (r *execRunner) Run(args ...string) (string, string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Env = append(os.Environ(), "LC_ALL="+DefaultLocale, "LANG="+DefaultLocale)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		return stdout.String(), stderr.String(), classifyError(stderr.String(), err)
+	}
+	return stdout.String(), stderr.String(), nil
+}
+
+func classifyError(stderr string, err error) error {
+	switch {
+	case strings.Contains(stderr, "not a git repository"):
+		return ErrNotARepo
+	case strings.Contains(stderr, "No such remote"):
+		return ErrRemoteMissing
+	default:
+		return err
+	}
+}
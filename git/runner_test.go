@@ -0,0 +1,23 @@
+package git
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestClassifyError(t *testing.T) {
+	cases := []struct {
+		stderr string
+		want   error
+	}{
+		{"fatal: not a git repository (or any of the parent directories): .git", ErrNotARepo},
+		{"fatal: No such remote 'origin'", ErrRemoteMissing},
+	}
+
+	for _, c := range cases {
+		got := classifyError(c.stderr, errors.New("exit status 128"))
+		if !errors.Is(got, c.want) {
+			t.Errorf("classifyError(%q) = %v, want %v", c.stderr, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+)
+
+// NewRemoteUrl parses a git remote URL into a RemoteInfo. It is built
+// on go-git's transport.NewEndpoint, which understands SCP-style
+// ("git@host:group/subgroup/repo.git"), ssh://, https:// and plain git://
+// URLs including ports, so it handles arbitrary subgroup depth without
+// the panics a manual strings.Split(url, "/") would produce.
+func NewRemoteUrl(url string) (*RemoteInfo, error) {
+	endpoint, err := transport.NewEndpoint(url)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Invalid remote url: %s", url))
+	}
+
+	path := strings.TrimPrefix(endpoint.Path, "/")
+	path = strings.TrimSuffix(path, ".git")
+	if path == "" {
+		return nil, errors.New(fmt.Sprintf("Invalid remote url: %s", url))
+	}
+
+	segments := strings.Split(path, "/")
+	if len(segments) < 2 {
+		return nil, errors.New(fmt.Sprintf("Invalid remote url: %s", url))
+	}
+
+	return &RemoteInfo{
+		Domain:     endpoint.Host,
+		Namespace:  strings.Join(segments[:len(segments)-1], "/"),
+		Repository: segments[len(segments)-1],
+	}, nil
+}
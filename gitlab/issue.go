@@ -0,0 +1,47 @@
+package gitlab
+
+import (
+	"github.com/lighttiger2505/lab/git"
+	go_gitlab "github.com/xanzy/go-gitlab"
+)
+
+// Issue wraps xanzy/go-gitlab's IssuesService, scoped to a single
+// project.
+type Issue struct {
+	api *go_gitlab.Client
+}
+
+func (i *Issue) List(project string, opt *go_gitlab.ListProjectIssuesOptions) ([]*go_gitlab.Issue, error) {
+	issues, _, err := i.api.Issues.ListProjectIssues(project, opt)
+	return issues, err
+}
+
+func (i *Issue) ListAll(opt *go_gitlab.ListIssuesOptions) ([]*go_gitlab.Issue, error) {
+	issues, _, err := i.api.Issues.ListIssues(opt)
+	return issues, err
+}
+
+func (i *Issue) Show(project string, iid int) (*go_gitlab.Issue, error) {
+	issue, _, err := i.api.Issues.GetIssue(project, iid)
+	return issue, err
+}
+
+func (i *Issue) Create(project string, opt *go_gitlab.CreateIssueOptions) (*go_gitlab.Issue, error) {
+	issue, _, err := i.api.Issues.CreateIssue(project, opt)
+	return issue, err
+}
+
+func (i *Issue) Update(project string, iid int, opt *go_gitlab.UpdateIssueOptions) (*go_gitlab.Issue, error) {
+	issue, _, err := i.api.Issues.UpdateIssue(project, iid, opt)
+	return issue, err
+}
+
+// GetIssueClient returns an Issue client authenticated against
+// remote's host.
+func (p *Provider) GetIssueClient(remote *git.RemoteInfo) (*Issue, error) {
+	api, err := p.client(remote)
+	if err != nil {
+		return nil, err
+	}
+	return &Issue{api: api}, nil
+}
@@ -0,0 +1,56 @@
+package gitlab
+
+import (
+	"github.com/lighttiger2505/lab/git"
+	go_gitlab "github.com/xanzy/go-gitlab"
+)
+
+// MergeRequest wraps xanzy/go-gitlab's MergeRequestsService, scoped to
+// a single project.
+type MergeRequest struct {
+	api *go_gitlab.Client
+}
+
+func (m *MergeRequest) List(project string, opt *go_gitlab.ListProjectMergeRequestsOptions) ([]*go_gitlab.MergeRequest, error) {
+	mergeRequests, _, err := m.api.MergeRequests.ListProjectMergeRequests(project, opt)
+	return mergeRequests, err
+}
+
+func (m *MergeRequest) Show(project string, iid int) (*go_gitlab.MergeRequest, error) {
+	mergeRequest, _, err := m.api.MergeRequests.GetMergeRequest(project, iid, nil)
+	return mergeRequest, err
+}
+
+func (m *MergeRequest) Create(project string, opt *go_gitlab.CreateMergeRequestOptions) (*go_gitlab.MergeRequest, error) {
+	mergeRequest, _, err := m.api.MergeRequests.CreateMergeRequest(project, opt)
+	return mergeRequest, err
+}
+
+func (m *MergeRequest) Update(project string, iid int, opt *go_gitlab.UpdateMergeRequestOptions) (*go_gitlab.MergeRequest, error) {
+	mergeRequest, _, err := m.api.MergeRequests.UpdateMergeRequest(project, iid, opt)
+	return mergeRequest, err
+}
+
+// Accept calls the Accept-MR API. The raw *go_gitlab.Response is
+// returned alongside the error so callers can tell a merge conflict
+// (405/409) apart from other failures.
+func (m *MergeRequest) Accept(project string, iid int, opt *go_gitlab.AcceptMergeRequestOptions) (*go_gitlab.MergeRequest, *go_gitlab.Response, error) {
+	return m.api.MergeRequests.AcceptMergeRequest(project, iid, opt)
+}
+
+// Changes returns the merge request along with its list of changed
+// files, used to build a conflict diagnostic when Accept fails.
+func (m *MergeRequest) Changes(project string, iid int) (*go_gitlab.MergeRequest, error) {
+	mergeRequest, _, err := m.api.MergeRequests.GetMergeRequestChanges(project, iid, nil)
+	return mergeRequest, err
+}
+
+// GetMergeRequestClient returns a MergeRequest client authenticated
+// against remote's host.
+func (p *Provider) GetMergeRequestClient(remote *git.RemoteInfo) (*MergeRequest, error) {
+	api, err := p.client(remote)
+	if err != nil {
+		return nil, err
+	}
+	return &MergeRequest{api: api}, nil
+}
@@ -0,0 +1,91 @@
+package gitlab
+
+import (
+	"io/ioutil"
+
+	"github.com/lighttiger2505/lab/git"
+	go_gitlab "github.com/xanzy/go-gitlab"
+)
+
+// Pipeline wraps xanzy/go-gitlab's PipelinesService and JobsService,
+// scoped to a single project, so lab can drive GitLab CI the way it
+// drives merge requests and issues.
+type Pipeline struct {
+	api *go_gitlab.Client
+}
+
+func (p *Pipeline) List(project string, opt *go_gitlab.ListProjectPipelinesOptions) ([]*go_gitlab.PipelineInfo, error) {
+	pipelines, _, err := p.api.Pipelines.ListProjectPipelines(project, opt)
+	return pipelines, err
+}
+
+// StatusForRef returns the most recent pipeline for ref, which may be
+// either a branch name or a commit SHA. Branch names are the common
+// case (e.g. the current branch), so they're tried first via the Ref
+// filter; a SHA filter is tried as a fallback since GitLab's Ref filter
+// won't match a bare commit SHA.
+func (p *Pipeline) StatusForRef(project, ref string) (*go_gitlab.PipelineInfo, error) {
+	pipelines, err := p.List(project, &go_gitlab.ListProjectPipelinesOptions{Ref: go_gitlab.String(ref)})
+	if err != nil {
+		return nil, err
+	}
+	if len(pipelines) > 0 {
+		return pipelines[0], nil
+	}
+
+	pipelines, err = p.List(project, &go_gitlab.ListProjectPipelinesOptions{SHA: go_gitlab.String(ref)})
+	if err != nil {
+		return nil, err
+	}
+	if len(pipelines) == 0 {
+		return nil, nil
+	}
+	return pipelines[0], nil
+}
+
+func (p *Pipeline) Retry(project string, pipelineID int) (*go_gitlab.Pipeline, error) {
+	pipeline, _, err := p.api.Pipelines.RetryPipelineBuild(project, pipelineID)
+	return pipeline, err
+}
+
+func (p *Pipeline) Cancel(project string, pipelineID int) (*go_gitlab.Pipeline, error) {
+	pipeline, _, err := p.api.Pipelines.CancelPipelineBuild(project, pipelineID)
+	return pipeline, err
+}
+
+func (p *Pipeline) ListJobs(project string, pipelineID int, opt *go_gitlab.ListJobsOptions) ([]*go_gitlab.Job, error) {
+	jobs, _, err := p.api.Jobs.ListPipelineJobs(project, pipelineID, opt)
+	return jobs, err
+}
+
+// Trace returns the full trace log of a job, streamed from the Jobs
+// API.
+func (p *Pipeline) Trace(project string, jobID int) (string, error) {
+	reader, _, err := p.api.Jobs.GetTraceFile(project, jobID)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+// Lint validates a ".gitlab-ci.yml" document via the /ci/lint API.
+func (p *Pipeline) Lint(content string) (*go_gitlab.LintResult, error) {
+	result, _, err := p.api.Validate.Lint(&go_gitlab.LintOptions{Content: go_gitlab.String(content)})
+	return result, err
+}
+
+// GetPipelineClient returns a Pipeline client authenticated against
+// remote's host.
+func (p *Provider) GetPipelineClient(remote *git.RemoteInfo) (*Pipeline, error) {
+	api, err := p.client(remote)
+	if err != nil {
+		return nil, err
+	}
+	return &Pipeline{api: api}, nil
+}
@@ -0,0 +1,28 @@
+package gitlab
+
+import (
+	"github.com/lighttiger2505/lab/git"
+	go_gitlab "github.com/xanzy/go-gitlab"
+)
+
+// Project wraps xanzy/go-gitlab's ProjectsService, used to resolve a
+// merge request's source project (e.g. a contributor's fork) to its
+// clone URL.
+type Project struct {
+	api *go_gitlab.Client
+}
+
+func (p *Project) Get(id int) (*go_gitlab.Project, error) {
+	project, _, err := p.api.Projects.GetProject(id, nil)
+	return project, err
+}
+
+// GetProjectClient returns a Project client authenticated against
+// remote's host.
+func (p *Provider) GetProjectClient(remote *git.RemoteInfo) (*Project, error) {
+	api, err := p.client(remote)
+	if err != nil {
+		return nil, err
+	}
+	return &Project{api: api}, nil
+}
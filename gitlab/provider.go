@@ -0,0 +1,98 @@
+// Package gitlab wires the command packages to the GitLab API, resolving
+// the git remote for the current repository into an authenticated
+// xanzy/go-gitlab client for the matching host.
+package gitlab
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/lighttiger2505/lab/config"
+	"github.com/lighttiger2505/lab/git"
+	go_gitlab "github.com/xanzy/go-gitlab"
+)
+
+// Provider resolves the current repository's GitLab remote against the
+// configured Hosts and hands out API clients scoped to it. It is
+// initialized once per command invocation via Init.
+type Provider struct {
+	GitClient git.Client
+	Hosts     []config.HostConfig
+
+	apis map[string]*go_gitlab.Client
+}
+
+// Init prepares the provider's client cache. It must be called before
+// any of the Get*Client methods.
+func (p *Provider) Init() error {
+	if len(p.Hosts) == 0 {
+		return errors.New("No private token configured, run `lab config add-host` first")
+	}
+	p.apis = make(map[string]*go_gitlab.Client)
+	return nil
+}
+
+// GetCurrentRemote resolves the repository's remote that matches one of
+// the configured Hosts, preferring a configured gitlab.com remote over
+// the others. It returns an error when no remote matches.
+func (p *Provider) GetCurrentRemote() (*git.RemoteInfo, error) {
+	remotes, err := p.GitClient.RemoteInfos()
+	if err != nil {
+		return nil, err
+	}
+
+	var fallback *git.RemoteInfo
+	for _, remote := range remotes {
+		if config.HasHost(p.Hosts, remote.Domain) {
+			if remote.Domain == config.DefaultDomain {
+				return remote, nil
+			}
+			if fallback == nil {
+				fallback = remote
+			}
+		}
+	}
+
+	if fallback != nil {
+		return fallback, nil
+	}
+
+	return nil, errors.New("Not a cloned repository from a configured gitlab host")
+}
+
+func (p *Provider) client(remote *git.RemoteInfo) (*go_gitlab.Client, error) {
+	if api, ok := p.apis[remote.Domain]; ok {
+		return api, nil
+	}
+
+	host, ok := config.FindHost(p.Hosts, remote.Domain)
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("No host configured for %s, run `lab config add-host`", remote.Domain))
+	}
+
+	httpClient := http.DefaultClient
+	if host.InsecureSkipVerify {
+		httpClient = &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			},
+		}
+	}
+
+	token := config.GetPrivateTokenFor(p.Hosts, host.Domain)
+	api := go_gitlab.NewClient(httpClient, token)
+	if host.Domain != config.DefaultDomain {
+		apiVersion := host.APIVersion
+		if apiVersion == "" {
+			apiVersion = config.DefaultAPIVersion
+		}
+		if err := api.SetBaseURL(fmt.Sprintf("https://%s/api/%s", host.Domain, apiVersion)); err != nil {
+			return nil, errors.New(fmt.Sprintf("Invalid host %s: %s", host.Domain, err.Error()))
+		}
+	}
+
+	p.apis[remote.Domain] = api
+	return api, nil
+}
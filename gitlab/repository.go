@@ -0,0 +1,33 @@
+package gitlab
+
+import (
+	"github.com/lighttiger2505/lab/git"
+	go_gitlab "github.com/xanzy/go-gitlab"
+)
+
+// Repository wraps xanzy/go-gitlab's RepositoryFilesService, used to
+// pull things like merge request and issue templates straight out of
+// the repository.
+type Repository struct {
+	api *go_gitlab.Client
+}
+
+// GetFile returns the raw content of path at ref (e.g. "master") in
+// project.
+func (r *Repository) GetFile(project, path, ref string) (string, error) {
+	file, _, err := r.api.RepositoryFiles.GetRawFile(project, path, &go_gitlab.GetRawFileOptions{Ref: go_gitlab.String(ref)})
+	if err != nil {
+		return "", err
+	}
+	return string(file), nil
+}
+
+// GetRepositoryClient returns a Repository client authenticated against
+// remote's host.
+func (p *Provider) GetRepositoryClient(remote *git.RemoteInfo) (*Repository, error) {
+	api, err := p.client(remote)
+	if err != nil {
+		return nil, err
+	}
+	return &Repository{api: api}, nil
+}
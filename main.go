@@ -10,6 +10,14 @@ import (
 	"runtime"
 	"strings"
 
+	"github.com/lighttiger2505/lab/commands/ci"
+	"github.com/lighttiger2505/lab/commands/clone"
+	cmdconfig "github.com/lighttiger2505/lab/commands/config"
+	"github.com/lighttiger2505/lab/commands/issue"
+	"github.com/lighttiger2505/lab/config"
+	"github.com/lighttiger2505/lab/git"
+	lab "github.com/lighttiger2505/lab/gitlab"
+	"github.com/lighttiger2505/lab/ui"
 	"github.com/mitchellh/cli"
 )
 
@@ -20,6 +28,8 @@ const (
 )
 
 type BrowseCommand struct {
+	GitClient git.Client
+	Hosts     []config.HostConfig
 }
 
 func (c *BrowseCommand) Synopsis() string {
@@ -42,128 +52,53 @@ func (c *BrowseCommand) Run(args []string) int {
 		return ExitCodeError
 	}
 
-	gitRemotes, err := GitRemotes()
+	gitRemotes, err := c.GitClient.RemoteInfos()
 	if err != nil {
 		fmt.Println(err.Error())
 		return ExitCodeError
 	}
 
-	gitlabRemote, err := FilterGitlabRemote(gitRemotes)
+	gitlabRemote, err := FilterGitlabRemote(gitRemotes, c.Hosts)
 	if err != nil {
 		fmt.Println(err.Error())
 		return ExitCodeError
 	}
 
 	browser := searchBrowserLauncher(runtime.GOOS)
-	cmdOutput(browser, []string{gitlabRemote.ConcatUrl()})
+	cmdOutput(browser, []string{concatUrl(gitlabRemote)})
 
 	return ExitCodeOK
 }
 
-func GitRemotes() ([]RemoteUrl, error) {
-	// Get remote repositorys
-	remotes := gitOutputs("git", []string{"remote"})
-
-	// Remote repository is not registered
-	if len(remotes) == 0 {
-		return nil, errors.New("No remote setting in this repository")
-	}
-
-	gitRemotes := make([]RemoteUrl, 1)
-	for _, remote := range remotes {
-		url := gitOutput("git", []string{"remote", "get-url", remote})
-
-		gitRemote, err := NewRemoteUrl(url)
-		if err != nil {
-			return nil, errors.New(fmt.Sprintf("Failed serialize remote url. %s", url))
-		}
-
-		gitRemotes = append(gitRemotes, *gitRemote)
-	}
-
-	return gitRemotes, nil
-}
-
-func FilterGitlabRemote(gitRemotes []RemoteUrl) (*RemoteUrl, error) {
-	var gitlabRemotes []RemoteUrl
+// FilterGitlabRemote returns a remote whose domain has a matching entry
+// in hosts. A gitlab.com remote is preferred when it is configured;
+// otherwise the first configured remote is returned. It returns an error
+// when no remote's domain has an entry in hosts.
+func FilterGitlabRemote(gitRemotes []*git.RemoteInfo, hosts []config.HostConfig) (*git.RemoteInfo, error) {
+	var fallback *git.RemoteInfo
 	for _, gitRemote := range gitRemotes {
-		if strings.HasPrefix(gitRemote.Domain, "gitlab") {
-			gitlabRemotes = append(gitlabRemotes, gitRemote)
+		if config.HasHost(hosts, gitRemote.Domain) {
+			if gitRemote.Domain == config.DefaultDomain {
+				return gitRemote, nil
+			}
+			if fallback == nil {
+				fallback = gitRemote
+			}
 		}
 	}
 
-	var gitLabRemote RemoteUrl
-	if len(gitlabRemotes) > 0 {
-		gitLabRemote = gitlabRemotes[0]
-	} else {
-		return nil, errors.New("Not a cloned repository from gitlab.")
+	if fallback != nil {
+		return fallback, nil
 	}
-	return &gitLabRemote, nil
-}
-
-
-	return ExitCodeOK
-}
 
-type RemoteUrl struct {
-	Url        string
-	Domain     string
-	User       string
-	Repository string
+	return nil, errors.New("Not a cloned repository from a configured gitlab host")
 }
 
-func (r *RemoteUrl) ConcatUrl() string {
-	params := strings.Join([]string{r.Domain, r.User, r.Repository}, "/")
+func concatUrl(r *git.RemoteInfo) string {
+	params := strings.Join([]string{r.Domain, r.RepositoryFullName()}, "/")
 	return "https://" + params
 }
 
-func NewRemoteUrl(url string) (*RemoteUrl, error) {
-	var (
-		otherScheme string
-		domain      string
-		user        string
-		repository  string
-	)
-
-	if strings.HasPrefix(url, "ssh") {
-		// ssh://git@gitlab.com/lighttiger2505/lab.git
-		otherScheme = strings.Split(url, "@")[1]
-		otherScheme = strings.TrimSuffix(otherScheme, ".git")
-	} else if strings.HasPrefix(url, "https") {
-		// https://github.com/lighttiger2505/lab
-		otherScheme = strings.Split(url, "//")[1]
-	} else {
-		return nil, errors.New(fmt.Sprintf("Invalid remote url: %s", url))
-	}
-
-	splitUrl := strings.Split(otherScheme, "/")
-	domain = splitUrl[0]
-	user = splitUrl[1]
-	repository = splitUrl[2]
-
-	return &RemoteUrl{
-		Url:        url,
-		Domain:     domain,
-		User:       user,
-		Repository: repository,
-	}, nil
-}
-
-func gitOutput(name string, args []string) string {
-	return gitOutputs(name, args)[0]
-}
-
-func gitOutputs(name string, args []string) []string {
-	var out = cmdOutput(name, args)
-	var outs []string
-	for _, line := range strings.Split(string(out), "\n") {
-		if strings.TrimSpace(line) != "" {
-			outs = append(outs, string(line))
-		}
-	}
-	return outs
-}
-
 func cmdOutput(name string, args []string) string {
 	out, err := exec.Command(name, args...).CombinedOutput()
 	if err != nil {
@@ -203,9 +138,82 @@ func main() {
 	c := cli.NewCLI("app", "1.0.0")
 	c.Args = os.Args[1:]
 
+	gitClient := git.NewClient()
+	hosts, err := config.Read()
+	if err != nil {
+		log.Println(err)
+	}
+
 	c.Commands = map[string]cli.CommandFactory{
 		"browse": func() (cli.Command, error) {
-			return &BrowseCommand{}, nil
+			return &BrowseCommand{
+				GitClient: gitClient,
+				Hosts:     hosts,
+			}, nil
+		},
+		"issue": func() (cli.Command, error) {
+			return &issue.IssueCommand{
+				Ui:        ui.NewBasicUi(),
+				GitClient: gitClient,
+				Provider: lab.Provider{
+					GitClient: gitClient,
+					Hosts:     hosts,
+				},
+			}, nil
+		},
+		"config add-host": func() (cli.Command, error) {
+			return &cmdconfig.AddHostCommand{
+				Ui: ui.NewBasicUi(),
+			}, nil
+		},
+		"clone": func() (cli.Command, error) {
+			return &clone.CloneCommand{
+				Ui:    ui.NewBasicUi(),
+				Hosts: hosts,
+			}, nil
+		},
+		"ci list": func() (cli.Command, error) {
+			return &ci.ListCommand{
+				Ui:       ui.NewBasicUi(),
+				Provider: lab.Provider{GitClient: gitClient, Hosts: hosts},
+			}, nil
+		},
+		"ci status": func() (cli.Command, error) {
+			return &ci.StatusCommand{
+				Ui:        ui.NewBasicUi(),
+				Provider:  lab.Provider{GitClient: gitClient, Hosts: hosts},
+				GitClient: gitClient,
+			}, nil
+		},
+		"ci jobs": func() (cli.Command, error) {
+			return &ci.JobsCommand{
+				Ui:       ui.NewBasicUi(),
+				Provider: lab.Provider{GitClient: gitClient, Hosts: hosts},
+			}, nil
+		},
+		"ci trace": func() (cli.Command, error) {
+			return &ci.TraceCommand{
+				Ui:       ui.NewBasicUi(),
+				Provider: lab.Provider{GitClient: gitClient, Hosts: hosts},
+			}, nil
+		},
+		"ci retry": func() (cli.Command, error) {
+			return &ci.RetryCommand{
+				Ui:       ui.NewBasicUi(),
+				Provider: lab.Provider{GitClient: gitClient, Hosts: hosts},
+			}, nil
+		},
+		"ci cancel": func() (cli.Command, error) {
+			return &ci.CancelCommand{
+				Ui:       ui.NewBasicUi(),
+				Provider: lab.Provider{GitClient: gitClient, Hosts: hosts},
+			}, nil
+		},
+		"ci lint": func() (cli.Command, error) {
+			return &ci.LintCommand{
+				Ui:       ui.NewBasicUi(),
+				Provider: lab.Provider{GitClient: gitClient, Hosts: hosts},
+			}, nil
 		},
 	}
 
@@ -215,4 +223,4 @@ func main() {
 	}
 
 	os.Exit(exitStatus)
-}
\ No newline at end of file
+}
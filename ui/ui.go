@@ -0,0 +1,40 @@
+// Package ui provides the thin output abstraction used by the command
+// packages so that Run implementations are testable without touching
+// os.Stdout/os.Stderr directly.
+package ui
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// Ui is implemented by anything that can surface command output and
+// errors to the user.
+type Ui interface {
+	Message(string)
+	Error(string)
+}
+
+// BasicUi is the default Ui used by the real CLI, writing Message to
+// Writer and Error to ErrorWriter.
+type BasicUi struct {
+	Writer      io.Writer
+	ErrorWriter io.Writer
+}
+
+// NewBasicUi returns a BasicUi writing to os.Stdout and os.Stderr.
+func NewBasicUi() *BasicUi {
+	return &BasicUi{
+		Writer:      os.Stdout,
+		ErrorWriter: os.Stderr,
+	}
+}
+
+func (u *BasicUi) Message(message string) {
+	fmt.Fprintln(u.Writer, message)
+}
+
+func (u *BasicUi) Error(message string) {
+	fmt.Fprintln(u.ErrorWriter, message)
+}